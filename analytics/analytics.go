@@ -0,0 +1,268 @@
+// Package analytics aggregates core.ParkingLot lifecycle events and
+// occupancy samples into rolling counters so a dashboard or /metrics
+// endpoint can answer "how busy has this lot been" without replaying raw
+// events itself.
+package analytics
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tronglv92/low_level_design_system/core"
+)
+
+// Windowed counts timestamped occurrences that fall within a trailing
+// sliding window, e.g. "how many VehicleEntered events in the last 24h".
+type Windowed struct {
+	mu     sync.Mutex
+	window time.Duration
+	events []time.Time
+}
+
+func NewWindowed(window time.Duration) *Windowed {
+	return &Windowed{window: window}
+}
+
+// Record adds an occurrence at time at and prunes anything that has since
+// fallen outside the window.
+func (w *Windowed) Record(at time.Time) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.events = append(w.events, at)
+	w.prune(at)
+}
+
+// Count reports how many occurrences remain inside the window as of at.
+func (w *Windowed) Count(at time.Time) int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.prune(at)
+	return len(w.events)
+}
+
+func (w *Windowed) prune(at time.Time) {
+	cutoff := at.Add(-w.window)
+	i := 0
+	for i < len(w.events) && w.events[i].Before(cutoff) {
+		i++
+	}
+	w.events = w.events[i:]
+}
+
+const (
+	occupancyBucketInterval = 5 * time.Minute
+	occupancyWindow         = 24 * time.Hour
+	occupancyBucketCount    = int(occupancyWindow / occupancyBucketInterval)
+)
+
+// occupancyBucket is one rolling-window sample of a level's occupancy.
+type occupancyBucket struct {
+	Start    time.Time `json:"start"`
+	Occupied int       `json:"occupied"`
+	Total    int       `json:"total"`
+}
+
+// levelOccupancy is a per-level ring buffer of occupancy buckets covering
+// the last 24h. Each ParkingLevel gets its own instance so concurrent
+// levels don't contend on a single lock.
+type levelOccupancy struct {
+	mu      sync.Mutex
+	buckets []occupancyBucket
+	head    int
+}
+
+func newLevelOccupancy() *levelOccupancy {
+	return &levelOccupancy{buckets: make([]occupancyBucket, occupancyBucketCount)}
+}
+
+func (lo *levelOccupancy) record(at time.Time, occupied, total int) {
+	lo.mu.Lock()
+	defer lo.mu.Unlock()
+	bucketStart := at.Truncate(occupancyBucketInterval)
+	if lo.buckets[lo.head].Start != bucketStart {
+		lo.head = (lo.head + 1) % occupancyBucketCount
+		lo.buckets[lo.head] = occupancyBucket{Start: bucketStart}
+	}
+	lo.buckets[lo.head].Occupied = occupied
+	lo.buckets[lo.head].Total = total
+}
+
+// snapshot returns the buckets still inside the 24h window as of at. The
+// ring only overwrites a slot when it wraps back around to it, so a level
+// that's gone quiet for a long stretch can leave buckets sitting in the
+// ring well past occupancyWindow - filtering on age here (the same way
+// Windowed.prune filters on at) is what actually keeps the window honest.
+func (lo *levelOccupancy) snapshot(at time.Time) []occupancyBucket {
+	lo.mu.Lock()
+	defer lo.mu.Unlock()
+	out := make([]occupancyBucket, 0, occupancyBucketCount)
+	for _, b := range lo.buckets {
+		if !b.Start.IsZero() && at.Sub(b.Start) <= occupancyWindow {
+			out = append(out, b)
+		}
+	}
+	return out
+}
+
+// Analytics aggregates event counts and rolling per-level occupancy for a
+// core.ParkingLot: a 24h Windowed counter per EventType, plus a sharded
+// levelOccupancy ring buffer per level so a high-concurrency workload isn't
+// serialized behind one lock.
+type Analytics struct {
+	mu        sync.Mutex
+	counters  map[core.EventType]*Windowed
+	occupancy map[int]*levelOccupancy
+
+	waitMu    sync.Mutex
+	waitTotal time.Duration
+	waitCount int
+}
+
+func NewAnalytics() *Analytics {
+	return &Analytics{
+		counters:  make(map[core.EventType]*Windowed),
+		occupancy: make(map[int]*levelOccupancy),
+	}
+}
+
+func (a *Analytics) counter(t core.EventType) *Windowed {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	c, ok := a.counters[t]
+	if !ok {
+		c = NewWindowed(occupancyWindow)
+		a.counters[t] = c
+	}
+	return c
+}
+
+func (a *Analytics) levelShard(levelID int) *levelOccupancy {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	lo, ok := a.occupancy[levelID]
+	if !ok {
+		lo = newLevelOccupancy()
+		a.occupancy[levelID] = lo
+	}
+	return lo
+}
+
+// RecordEvent folds evt into its event-type counter, additionally tracking
+// the running average for WaitTime events.
+func (a *Analytics) RecordEvent(evt core.Event) {
+	a.counter(evt.Type).Record(evt.At)
+	if evt.Type == core.WaitTime {
+		a.waitMu.Lock()
+		a.waitTotal += evt.Duration
+		a.waitCount++
+		a.waitMu.Unlock()
+	}
+}
+
+// RecordOccupancy samples a level's current occupancy into its rolling
+// window bucket.
+func (a *Analytics) RecordOccupancy(levelID int, at time.Time, occupied, total int) {
+	a.levelShard(levelID).record(at, occupied, total)
+}
+
+// EventCount reports how many events of type t happened in the last 24h, as
+// of at.
+func (a *Analytics) EventCount(t core.EventType, at time.Time) int {
+	return a.counter(t).Count(at)
+}
+
+// AverageWaitTime reports the mean WaitTime event duration recorded so far.
+func (a *Analytics) AverageWaitTime() time.Duration {
+	a.waitMu.Lock()
+	defer a.waitMu.Unlock()
+	if a.waitCount == 0 {
+		return 0
+	}
+	return a.waitTotal / time.Duration(a.waitCount)
+}
+
+// PeakUsage reports the bucket with the highest occupied/total ratio
+// recorded for levelID in the last 24h, as of at.
+func (a *Analytics) PeakUsage(levelID int, at time.Time) (bucketStart time.Time, occupied, total int) {
+	for _, b := range a.levelShard(levelID).snapshot(at) {
+		if total == 0 || (b.Total > 0 && float64(b.Occupied)/float64(b.Total) > float64(occupied)/float64(total)) {
+			bucketStart, occupied, total = b.Start, b.Occupied, b.Total
+		}
+	}
+	return
+}
+
+// ExportJSON renders event counts and per-level occupancy history as JSON,
+// suitable for a dashboard to poll.
+func (a *Analytics) ExportJSON(at time.Time) ([]byte, error) {
+	snapshot := struct {
+		EventCounts map[string]int               `json:"event_counts"`
+		Occupancy   map[string][]occupancyBucket `json:"occupancy_by_level"`
+	}{
+		EventCounts: map[string]int{
+			core.VehicleEntered.String(): a.EventCount(core.VehicleEntered, at),
+			core.VehicleExited.String():  a.EventCount(core.VehicleExited, at),
+			core.SpotDenied.String():     a.EventCount(core.SpotDenied, at),
+			core.WaitTime.String():       a.EventCount(core.WaitTime, at),
+		},
+		Occupancy: make(map[string][]occupancyBucket),
+	}
+
+	a.mu.Lock()
+	levelIDs := make([]int, 0, len(a.occupancy))
+	for id := range a.occupancy {
+		levelIDs = append(levelIDs, id)
+	}
+	a.mu.Unlock()
+
+	for _, id := range levelIDs {
+		snapshot.Occupancy[fmt.Sprintf("%d", id)] = a.levelShard(id).snapshot(at)
+	}
+	return json.Marshal(snapshot)
+}
+
+// ExportPrometheus renders the event counters and average wait time in
+// Prometheus text exposition format, suitable for a /metrics endpoint.
+func (a *Analytics) ExportPrometheus(at time.Time) string {
+	var b strings.Builder
+	b.WriteString("# HELP parking_events_total Count of parking lot events in the last 24h\n")
+	b.WriteString("# TYPE parking_events_total gauge\n")
+	for _, t := range []core.EventType{core.VehicleEntered, core.VehicleExited, core.SpotDenied, core.WaitTime} {
+		fmt.Fprintf(&b, "parking_events_total{type=%q} %d\n", t, a.EventCount(t, at))
+	}
+	b.WriteString("# HELP parking_average_wait_seconds Average WaitTime event duration, in seconds\n")
+	b.WriteString("# TYPE parking_average_wait_seconds gauge\n")
+	fmt.Fprintf(&b, "parking_average_wait_seconds %f\n", a.AverageWaitTime().Seconds())
+	return b.String()
+}
+
+// AnalyticsObserver fans both raw spot-state changes and lifecycle Events
+// into an Analytics aggregator, replacing the toy printf observer with a
+// real metrics sink.
+type AnalyticsObserver struct {
+	lot       *core.ParkingLot
+	analytics *Analytics
+	clock     core.Clock
+}
+
+func NewAnalyticsObserver(lot *core.ParkingLot, analytics *Analytics) *AnalyticsObserver {
+	return &AnalyticsObserver{lot: lot, analytics: analytics, clock: core.RealClock{}}
+}
+
+// Update samples the occupancy of the level the changed spot belongs to.
+func (o *AnalyticsObserver) Update(spot *core.ParkingSpot) {
+	level := o.lot.LevelByID(spot.LevelID)
+	if level == nil {
+		return
+	}
+	occupied, total := level.Occupancy()
+	o.analytics.RecordOccupancy(level.LevelID, o.clock.Now(), occupied, total)
+}
+
+// UpdateEvent records a lifecycle event into the analytics counters.
+func (o *AnalyticsObserver) UpdateEvent(evt core.Event) {
+	o.analytics.RecordEvent(evt)
+}
@@ -0,0 +1,121 @@
+package analytics
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tronglv92/low_level_design_system/core"
+)
+
+func TestWindowed_CountExcludesEventsOutsideWindow(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	w := NewWindowed(24 * time.Hour)
+
+	w.Record(start)
+	if got := w.Count(start.Add(time.Hour)); got != 1 {
+		t.Fatalf("expected 1 event still in window, got %d", got)
+	}
+	if got := w.Count(start.Add(25 * time.Hour)); got != 0 {
+		t.Fatalf("expected the event to have aged out of the 24h window, got %d", got)
+	}
+}
+
+// TestLevelOccupancy_StaleBucketExcludedAfterQuietPeriod is a regression
+// test: the ring only overwrites a slot when it wraps back around to it, so
+// a single recorded bucket followed by a long quiet period (no further
+// Record calls) used to still be returned by snapshot as if current. at
+// must be compared against each bucket's Start to actually enforce the
+// advertised 24h window.
+func TestLevelOccupancy_StaleBucketExcludedAfterQuietPeriod(t *testing.T) {
+	lo := newLevelOccupancy()
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	lo.record(start, 2, 4)
+	if got := lo.snapshot(start.Add(time.Hour)); len(got) != 1 {
+		t.Fatalf("expected 1 bucket shortly after recording, got %d", len(got))
+	}
+
+	quiet := start.Add(25 * time.Hour)
+	if got := lo.snapshot(quiet); len(got) != 0 {
+		t.Fatalf("expected the stale bucket to be excluded after a quiet 25h, got %d buckets: %+v", len(got), got)
+	}
+}
+
+func TestAnalytics_RecordOccupancy_ExportJSONExcludesStaleBuckets(t *testing.T) {
+	a := NewAnalytics()
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	a.RecordOccupancy(1, start, 1, 2)
+
+	raw, err := a.ExportJSON(start.Add(25 * time.Hour))
+	if err != nil {
+		t.Fatalf("ExportJSON: %v", err)
+	}
+	var decoded struct {
+		Occupancy map[string][]occupancyBucket `json:"occupancy_by_level"`
+	}
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if buckets := decoded.Occupancy["1"]; len(buckets) != 0 {
+		t.Fatalf("expected no stale occupancy buckets in export, got %+v", buckets)
+	}
+}
+
+func TestAnalytics_PeakUsage_PicksHighestRatioWithinWindow(t *testing.T) {
+	a := NewAnalytics()
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	a.RecordOccupancy(1, start, 1, 4)                              // 25%
+	a.RecordOccupancy(1, start.Add(occupancyBucketInterval), 3, 4) // 75%
+
+	at := start.Add(occupancyBucketInterval + time.Minute)
+	bucketStart, occupied, total := a.PeakUsage(1, at)
+	if occupied != 3 || total != 4 {
+		t.Fatalf("expected the 75%% bucket to win, got occupied=%d total=%d at %v", occupied, total, bucketStart)
+	}
+}
+
+func TestAnalytics_RecordEvent_CountsByType(t *testing.T) {
+	a := NewAnalytics()
+	now := time.Now()
+	a.RecordEvent(core.Event{Type: core.VehicleEntered, At: now})
+	a.RecordEvent(core.Event{Type: core.VehicleEntered, At: now})
+	a.RecordEvent(core.Event{Type: core.SpotDenied, At: now})
+
+	if got := a.EventCount(core.VehicleEntered, now); got != 2 {
+		t.Fatalf("expected 2 VehicleEntered events, got %d", got)
+	}
+	if got := a.EventCount(core.SpotDenied, now); got != 1 {
+		t.Fatalf("expected 1 SpotDenied event, got %d", got)
+	}
+	if got := a.EventCount(core.VehicleExited, now); got != 0 {
+		t.Fatalf("expected 0 VehicleExited events, got %d", got)
+	}
+}
+
+func TestAnalytics_AverageWaitTime(t *testing.T) {
+	a := NewAnalytics()
+	now := time.Now()
+	a.RecordEvent(core.Event{Type: core.WaitTime, Duration: 2 * time.Minute, At: now})
+	a.RecordEvent(core.Event{Type: core.WaitTime, Duration: 4 * time.Minute, At: now})
+
+	if got := a.AverageWaitTime(); got != 3*time.Minute {
+		t.Fatalf("expected average wait of 3m, got %v", got)
+	}
+}
+
+func TestAnalytics_ExportPrometheus_RendersCountersAndAverage(t *testing.T) {
+	a := NewAnalytics()
+	now := time.Now()
+	a.RecordEvent(core.Event{Type: core.VehicleEntered, At: now})
+	a.RecordEvent(core.Event{Type: core.WaitTime, Duration: time.Minute, At: now})
+
+	out := a.ExportPrometheus(now)
+	if !strings.Contains(out, `parking_events_total{type="vehicle_entered"} 1`) {
+		t.Fatalf("expected vehicle_entered counter in output, got:\n%s", out)
+	}
+	if !strings.Contains(out, "parking_average_wait_seconds 60.000000") {
+		t.Fatalf("expected average wait of 60s in output, got:\n%s", out)
+	}
+}
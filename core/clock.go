@@ -0,0 +1,14 @@
+package core
+
+import "time"
+
+// Clock abstracts wall-clock time so billing and reservation logic can be
+// driven by a fake clock in tests instead of real Sleep calls.
+type Clock interface {
+	Now() time.Time
+}
+
+// RealClock is the default Clock, backed by time.Now.
+type RealClock struct{}
+
+func (RealClock) Now() time.Time { return time.Now() }
@@ -0,0 +1,191 @@
+// Package coordinator owns the mapping between a vehicle's identity and the
+// spot it currently holds, issuing and redeeming Tickets so gates don't have
+// to remember which spot a vehicle is in themselves.
+package coordinator
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/tronglv92/low_level_design_system/core"
+)
+
+// Ticket is handed to a vehicle on entry and is the only thing a caller
+// needs to exit later - it replaces passing around a *core.ParkingLevel and
+// *core.ParkingSpot pair that the caller had to remember (and could get wrong).
+type Ticket struct {
+	VehicleID   core.VehicleID
+	VehicleType core.VehicleType
+	Spot        *core.ParkingSpot
+	Level       *core.ParkingLevel
+	EntryTime   time.Time
+}
+
+// Receipt is issued when a Ticket is redeemed on exit.
+type Receipt struct {
+	VehicleID core.VehicleID
+	Duration  time.Duration
+	Amount    float64
+	LineItems []core.LineItem
+}
+
+// CoordinatorObserver is notified of vehicle lifecycle events, as opposed to
+// core.Observer which is notified of raw spot state changes.
+type CoordinatorObserver interface {
+	VehicleAssigned(vehicle core.Vehicle, ticket Ticket)
+	VehicleReleased(vehicle core.Vehicle, receipt Receipt)
+}
+
+type assignment struct {
+	vehicle core.Vehicle
+	spot    *core.ParkingSpot
+	level   *core.ParkingLevel
+	ticket  Ticket
+}
+
+// Coordinator owns the mapping between a VehicleID and the spot it was
+// assigned, so gates no longer need to remember which spot a vehicle is in.
+// It is safe for concurrent use by multiple gates and refuses to
+// double-park a vehicle that already holds an active ticket.
+type Coordinator struct {
+	lot   *core.ParkingLot
+	clock core.Clock
+
+	mu          sync.Mutex
+	assignments map[core.VehicleID]assignment
+	observers   []CoordinatorObserver
+}
+
+func NewCoordinator(lot *core.ParkingLot) *Coordinator {
+	return &Coordinator{
+		lot:         lot,
+		clock:       core.RealClock{},
+		assignments: make(map[core.VehicleID]assignment),
+	}
+}
+
+// SetClock overrides the coordinator's wall clock, e.g. with a fake clock in
+// tests so billing duration isn't at the mercy of real Sleep calls.
+func (c *Coordinator) SetClock(clock core.Clock) {
+	c.clock = clock
+}
+
+func (c *Coordinator) RegisterObserver(observer CoordinatorObserver) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.observers = append(c.observers, observer)
+}
+
+// Assign finds a spot for vehicle and records the assignment against its
+// VehicleID. It returns an error if the vehicle already holds an active
+// ticket or no spot of its type is free. The spot search runs outside c.mu
+// so concurrent entries of different vehicles don't serialize behind one
+// lock - only the assignments map check-and-insert is guarded, and a spot
+// claimed for a vehicle that turns out to already be parked is handed back
+// rather than leaked.
+func (c *Coordinator) Assign(v core.Vehicle) (Ticket, error) {
+	c.mu.Lock()
+	if _, alreadyParked := c.assignments[v.ID()]; alreadyParked {
+		c.mu.Unlock()
+		return Ticket{}, fmt.Errorf("vehicle %s is already parked", v.ID())
+	}
+	c.mu.Unlock()
+
+	spot, level := c.lot.FindAndParkVehicle(v)
+	if spot == nil {
+		return Ticket{}, fmt.Errorf("no available spot for vehicle type %v", v.GetType())
+	}
+
+	ticket := Ticket{VehicleID: v.ID(), VehicleType: v.GetType(), Spot: spot, Level: level, EntryTime: c.clock.Now()}
+
+	c.mu.Lock()
+	if _, alreadyParked := c.assignments[v.ID()]; alreadyParked {
+		c.mu.Unlock()
+		level.ReleaseVehicle(spot)
+		return Ticket{}, fmt.Errorf("vehicle %s is already parked", v.ID())
+	}
+	c.assignments[v.ID()] = assignment{vehicle: v, spot: spot, level: level, ticket: ticket}
+	observers := c.observers
+	c.mu.Unlock()
+
+	for _, observer := range observers {
+		observer.VehicleAssigned(v, ticket)
+	}
+	return ticket, nil
+}
+
+// AssignReserved records an assignment for a vehicle that has already been
+// placed in spot by core.ParkingLot.CheckIn, issuing it a real Ticket the
+// same way Assign does for a walk-in. This is what lets a checked-in
+// reservation be billed, show up in analytics, and be exited through
+// EntryExitGate.Exit like any other parked vehicle. It returns an error if
+// the vehicle already holds an active ticket - in which case spot has
+// already been parked into by CheckIn and is released back to level the
+// same way Assign rolls back its own already-parked race, so it doesn't
+// get stuck occupied with no ticket able to free it.
+func (c *Coordinator) AssignReserved(v core.Vehicle, spot *core.ParkingSpot, level *core.ParkingLevel) (Ticket, error) {
+	c.mu.Lock()
+	if _, alreadyParked := c.assignments[v.ID()]; alreadyParked {
+		c.mu.Unlock()
+		level.ReleaseVehicle(spot)
+		return Ticket{}, fmt.Errorf("vehicle %s is already parked", v.ID())
+	}
+
+	ticket := Ticket{VehicleID: v.ID(), VehicleType: v.GetType(), Spot: spot, Level: level, EntryTime: c.clock.Now()}
+	c.assignments[v.ID()] = assignment{vehicle: v, spot: spot, level: level, ticket: ticket}
+	observers := c.observers
+	c.mu.Unlock()
+
+	for _, observer := range observers {
+		observer.VehicleAssigned(v, ticket)
+	}
+	return ticket, nil
+}
+
+// Release closes out a ticket, frees its spot, and returns a Receipt. It
+// returns an error if the ticket no longer matches an active assignment.
+func (c *Coordinator) Release(ticket Ticket) (Receipt, error) {
+	c.mu.Lock()
+	a, ok := c.assignments[ticket.VehicleID]
+	if !ok || a.spot != ticket.Spot {
+		c.mu.Unlock()
+		return Receipt{}, fmt.Errorf("no active assignment for vehicle %s", ticket.VehicleID)
+	}
+	delete(c.assignments, ticket.VehicleID)
+	c.mu.Unlock()
+
+	a.level.ReleaseVehicle(a.spot)
+	c.lot.NotifyObservers(a.spot)
+
+	receipt := Receipt{VehicleID: ticket.VehicleID, Duration: c.clock.Now().Sub(ticket.EntryTime)}
+	c.mu.Lock()
+	observers := c.observers
+	c.mu.Unlock()
+	for _, observer := range observers {
+		observer.VehicleReleased(a.vehicle, receipt)
+	}
+	return receipt, nil
+}
+
+// Lookup reports the spot currently assigned to vehicleID, if any.
+func (c *Coordinator) Lookup(vehicleID string) (*core.ParkingSpot, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	a, ok := c.assignments[core.VehicleID(vehicleID)]
+	if !ok {
+		return nil, false
+	}
+	return a.spot, true
+}
+
+// ActiveVehicles lists every vehicle currently holding a ticket.
+func (c *Coordinator) ActiveVehicles() []core.Vehicle {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	vehicles := make([]core.Vehicle, 0, len(c.assignments))
+	for _, a := range c.assignments {
+		vehicles = append(vehicles, a.vehicle)
+	}
+	return vehicles
+}
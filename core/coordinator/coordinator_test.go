@@ -0,0 +1,165 @@
+package coordinator
+
+import (
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tronglv92/low_level_design_system/core"
+)
+
+// fakeClock is a Clock whose Now() is set explicitly, so billing-duration
+// assertions aren't at the mercy of real Sleep calls.
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func newFakeClock(start time.Time) *fakeClock {
+	return &fakeClock{now: start}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func newTestLot(spotsPerType int) *core.ParkingLot {
+	var spots []*core.ParkingSpot
+	id := 1
+	for _, vt := range []core.VehicleType{core.Car, core.Motorcycle, core.Truck} {
+		for i := 0; i < spotsPerType; i++ {
+			spots = append(spots, &core.ParkingSpot{SpotID: id, SpotType: vt})
+			id++
+		}
+	}
+	level := core.NewParkingLevel(1, spots)
+	return &core.ParkingLot{Levels: []*core.ParkingLevel{level}}
+}
+
+// TestCoordinator_Release_UsesClockForDuration asserts that a Ticket's
+// billed Duration reflects the fake clock's advance between Assign and
+// Release, not wall-clock time.
+func TestCoordinator_Release_UsesClockForDuration(t *testing.T) {
+	lot := newTestLot(1)
+	coord := NewCoordinator(lot)
+	clock := newFakeClock(time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC))
+	coord.SetClock(clock)
+
+	vehicle := core.CarVehicle{Plate: "CAR-1"}
+	ticket, err := coord.Assign(vehicle)
+	if err != nil {
+		t.Fatalf("Assign: %v", err)
+	}
+
+	clock.Advance(90 * time.Minute)
+
+	receipt, err := coord.Release(ticket)
+	if err != nil {
+		t.Fatalf("Release: %v", err)
+	}
+	if receipt.Duration != 90*time.Minute {
+		t.Fatalf("expected duration 90m, got %v", receipt.Duration)
+	}
+}
+
+// TestCoordinator_ConcurrentEntryExit drives many goroutines entering and
+// exiting through the same Coordinator concurrently and asserts that every
+// vehicle is assigned exactly once and the set of active vehicles matches
+// what's left parked, catching regressions in Assign's narrowed lock scope.
+func TestCoordinator_ConcurrentEntryExit(t *testing.T) {
+	const vehicles = 50
+	lot := newTestLot(vehicles) // enough spots that every vehicle always gets one
+	coord := NewCoordinator(lot)
+	coord.SetClock(newFakeClock(time.Now()))
+
+	var assigned int32
+	var wg sync.WaitGroup
+	tickets := make([]Ticket, vehicles)
+	for i := 0; i < vehicles; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			ticket, err := coord.Assign(fakeVehicle{vt: core.Car, id: "CAR-" + strconv.Itoa(i)})
+			if err != nil {
+				return
+			}
+			atomic.AddInt32(&assigned, 1)
+			tickets[i] = ticket
+		}(i)
+	}
+	wg.Wait()
+
+	if int(assigned) != vehicles {
+		t.Fatalf("expected all %d vehicles assigned, got %d", vehicles, assigned)
+	}
+	if len(coord.ActiveVehicles()) != vehicles {
+		t.Fatalf("expected %d active vehicles, got %d", vehicles, len(coord.ActiveVehicles()))
+	}
+
+	var wg2 sync.WaitGroup
+	for i := 0; i < vehicles; i++ {
+		wg2.Add(1)
+		go func(i int) {
+			defer wg2.Done()
+			if _, err := coord.Release(tickets[i]); err != nil {
+				t.Errorf("Release(%d): %v", i, err)
+			}
+		}(i)
+	}
+	wg2.Wait()
+
+	if len(coord.ActiveVehicles()) != 0 {
+		t.Fatalf("expected 0 active vehicles after release, got %d", len(coord.ActiveVehicles()))
+	}
+}
+
+// TestCoordinator_AssignReserved_AlreadyParked_ReleasesSpot is a regression
+// test for AssignReserved leaking spot when the vehicle already holds an
+// active ticket: spot was already parked into by ParkingLot.CheckIn before
+// AssignReserved is called, so the already-parked error path must release
+// it back to level the same way Assign rolls back its own race, or the spot
+// is stuck occupied forever with no ticket able to free it.
+func TestCoordinator_AssignReserved_AlreadyParked_ReleasesSpot(t *testing.T) {
+	lot := newTestLot(1)
+	coord := NewCoordinator(lot)
+
+	vehicle := fakeVehicle{vt: core.Car, id: "CAR-1"}
+	if _, err := coord.Assign(vehicle); err != nil {
+		t.Fatalf("Assign: %v", err)
+	}
+
+	level := lot.Levels[0]
+	var otherSpot *core.ParkingSpot
+	for _, s := range level.Spots {
+		if s.SpotType == core.Car {
+			otherSpot = s
+		}
+	}
+	otherSpot.Park() // simulate ParkingLot.CheckIn already having parked this vehicle into another spot
+
+	if _, err := coord.AssignReserved(vehicle, otherSpot, level); err == nil {
+		t.Fatal("expected AssignReserved to reject an already-parked vehicle")
+	}
+
+	if otherSpot.Occupied() {
+		t.Fatal("AssignReserved left the spot occupied after rejecting the assignment - it leaked")
+	}
+}
+
+type fakeVehicle struct {
+	vt core.VehicleType
+	id string
+}
+
+func (f fakeVehicle) GetType() core.VehicleType { return f.vt }
+func (f fakeVehicle) ID() core.VehicleID        { return core.VehicleID(f.id) }
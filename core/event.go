@@ -0,0 +1,49 @@
+package core
+
+import "time"
+
+// Observer interface
+type Observer interface {
+	Update(spot *ParkingSpot)
+}
+
+// EventType classifies an Event for analytics purposes.
+type EventType int
+
+const (
+	VehicleEntered EventType = iota
+	VehicleExited
+	SpotDenied
+	WaitTime
+)
+
+func (t EventType) String() string {
+	switch t {
+	case VehicleEntered:
+		return "vehicle_entered"
+	case VehicleExited:
+		return "vehicle_exited"
+	case SpotDenied:
+		return "spot_denied"
+	case WaitTime:
+		return "wait_time"
+	default:
+		return "unknown"
+	}
+}
+
+// Event is a single countable occurrence, fanned out to EventObservers in
+// addition to the raw spot-state Update(spot) callback.
+type Event struct {
+	Type        EventType
+	LevelID     int
+	VehicleType VehicleType
+	Duration    time.Duration // populated for WaitTime events
+	At          time.Time
+}
+
+// EventObserver receives lifecycle events, complementing Observer's
+// per-spot state-change callback.
+type EventObserver interface {
+	UpdateEvent(evt Event)
+}
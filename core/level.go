@@ -0,0 +1,91 @@
+package core
+
+// ParkingLevel holds its spots plus a per-VehicleType free-set: a buffered
+// channel of currently-free spots of that type. Parking a vehicle is an
+// atomic channel receive (claim) instead of a linear scan that takes a
+// spot-level lock on every spot, and it closes the race the old
+// CanPark-then-Park two-step had, where two goroutines could both see
+// CanPark return true for the same spot before either called Park - the
+// channel receive itself is the only place a spot is handed out, so two
+// callers can never be given the same one.
+type ParkingLevel struct {
+	LevelID int
+	Spots   []*ParkingSpot
+
+	free map[VehicleType]chan *ParkingSpot
+}
+
+// NewParkingLevel builds a level, stamps its LevelID onto every spot, and
+// seeds the per-type free-set with whatever spots start out unoccupied and
+// unreserved.
+func NewParkingLevel(levelID int, spots []*ParkingSpot) *ParkingLevel {
+	counts := make(map[VehicleType]int)
+	for _, spot := range spots {
+		spot.LevelID = levelID
+		counts[spot.SpotType]++
+	}
+
+	free := make(map[VehicleType]chan *ParkingSpot, len(counts))
+	for vt, n := range counts {
+		free[vt] = make(chan *ParkingSpot, n)
+	}
+
+	level := &ParkingLevel{LevelID: levelID, Spots: spots, free: free}
+	for _, spot := range spots {
+		if !spot.IsOccupied && spot.ReservationID == "" {
+			level.free[spot.SpotType] <- spot
+		}
+	}
+	return level
+}
+
+// Occupancy reports how many of the level's spots are currently occupied.
+func (pl *ParkingLevel) Occupancy() (occupied, total int) {
+	for _, spot := range pl.Spots {
+		total++
+		spot.mu.Lock()
+		if spot.IsOccupied {
+			occupied++
+		}
+		spot.mu.Unlock()
+	}
+	return
+}
+
+// claimFreeSpot atomically pops a free spot of vehicle's type off the
+// index, or returns nil if none is free right now. The spot is considered
+// claimed the instant it comes off the channel - the caller owns it and no
+// other claimFreeSpot call can receive the same value.
+func (pl *ParkingLevel) claimFreeSpot(vehicle Vehicle) *ParkingSpot {
+	ch, ok := pl.free[vehicle.GetType()]
+	if !ok {
+		return nil
+	}
+	select {
+	case spot := <-ch:
+		return spot
+	default:
+		return nil
+	}
+}
+
+// releaseToFree returns spot to its type's free-set, e.g. after a vehicle
+// exits or a reservation hold is cancelled or expires without a check-in.
+func (pl *ParkingLevel) releaseToFree(spot *ParkingSpot) {
+	ch, ok := pl.free[spot.SpotType]
+	if !ok {
+		return
+	}
+	ch <- spot
+}
+
+func (pl *ParkingLevel) ParkVehicle(spot *ParkingSpot, vehicle *Vehicle) {
+
+	spot.Park()
+}
+
+func (pl *ParkingLevel) ReleaseVehicle(spot *ParkingSpot) {
+
+	spot.Leave()
+	pl.releaseToFree(spot)
+}
@@ -0,0 +1,91 @@
+package core
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func newBenchLevel(spotsPerType int) *ParkingLevel {
+	var spots []*ParkingSpot
+	id := 1
+	for _, vt := range []VehicleType{Car, Motorcycle, Truck} {
+		for i := 0; i < spotsPerType; i++ {
+			spots = append(spots, &ParkingSpot{SpotID: id, SpotType: vt})
+			id++
+		}
+	}
+	return NewParkingLevel(1, spots)
+}
+
+// TestParkingLevel_ConcurrentClaim_NoDoubleBooking drives many goroutines at
+// a level with far fewer spots than vehicles and asserts that every claimed
+// spot is handed to exactly one caller - the race this guards against is
+// the old CanPark-then-Park two-step, where two goroutines could both
+// observe a spot as free before either parked in it.
+func TestParkingLevel_ConcurrentClaim_NoDoubleBooking(t *testing.T) {
+	const spotsPerType = 10
+	const vehiclesPerType = 200
+	level := newBenchLevel(spotsPerType)
+
+	var mu sync.Mutex
+	claimedBy := make(map[*ParkingSpot]string)
+
+	var wg sync.WaitGroup
+	for _, vt := range []VehicleType{Car, Motorcycle, Truck} {
+		for i := 0; i < vehiclesPerType; i++ {
+			wg.Add(1)
+			go func(vt VehicleType, i int) {
+				defer wg.Done()
+				vehicle := CarVehicle{Plate: fmt.Sprintf("%v-%d", vt, i)}
+				spot := level.claimFreeSpot(fakeVehicle{vt: vt, id: vehicle.Plate})
+				if spot == nil {
+					return
+				}
+				level.ParkVehicle(spot, nil)
+
+				mu.Lock()
+				if owner, ok := claimedBy[spot]; ok {
+					t.Errorf("spot %d claimed by both %q and %q", spot.SpotID, owner, vehicle.Plate)
+				}
+				claimedBy[spot] = vehicle.Plate
+				mu.Unlock()
+			}(vt, i)
+		}
+	}
+	wg.Wait()
+
+	if len(claimedBy) != spotsPerType*3 {
+		t.Fatalf("expected exactly %d spots claimed, got %d", spotsPerType*3, len(claimedBy))
+	}
+}
+
+type fakeVehicle struct {
+	vt VehicleType
+	id string
+}
+
+func (f fakeVehicle) GetType() VehicleType { return f.vt }
+func (f fakeVehicle) ID() VehicleID        { return VehicleID(f.id) }
+
+// BenchmarkPark_10k_Concurrent drives 10k concurrent claimFreeSpot/releaseToFree
+// round-trips against a small level, the same contention shape as Test Case
+// 4's 10 goroutines racing over 4 spots, to demonstrate the per-type
+// free-spot channel index scales instead of serializing behind a linear
+// scan with a per-spot lock.
+func BenchmarkPark_10k_Concurrent(b *testing.B) {
+	level := newBenchLevel(25)
+	vehicle := fakeVehicle{vt: Car}
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			spot := level.claimFreeSpot(vehicle)
+			if spot == nil {
+				continue
+			}
+			level.ParkVehicle(spot, nil)
+			level.ReleaseVehicle(spot)
+		}
+	})
+}
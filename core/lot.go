@@ -0,0 +1,263 @@
+package core
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+type ParkingLot struct {
+	Levels         []*ParkingLevel
+	observers      []Observer
+	eventObservers []EventObserver
+
+	reservationStore ReservationStore
+	reservationGrace time.Duration // how long a no-show spot is held past its window
+
+	resMu          sync.Mutex // guards reservationSeq
+	reservationSeq int64
+
+	revMu   sync.Mutex // guards revenue
+	revenue float64
+}
+
+// RegisterEventObserver subscribes observer to lifecycle Events, e.g. an
+// AnalyticsObserver.
+func (pl *ParkingLot) RegisterEventObserver(observer EventObserver) {
+	pl.eventObservers = append(pl.eventObservers, observer)
+}
+
+// NotifyEvent fans evt out to every registered EventObserver.
+func (pl *ParkingLot) NotifyEvent(evt Event) {
+	for _, observer := range pl.eventObservers {
+		observer.UpdateEvent(evt)
+	}
+}
+
+// LevelByID finds a level by its LevelID, or nil if none matches.
+func (pl *ParkingLot) LevelByID(id int) *ParkingLevel {
+	for _, level := range pl.Levels {
+		if level.LevelID == id {
+			return level
+		}
+	}
+	return nil
+}
+
+// OccupancyPercent reports what fraction of spots across all levels are
+// currently occupied, as a percentage in [0, 100]. DynamicPricing reads this
+// to decide whether to apply a surge surcharge.
+func (pl *ParkingLot) OccupancyPercent() float64 {
+	total, occupied := 0, 0
+	for _, level := range pl.Levels {
+		for _, spot := range level.Spots {
+			total++
+			spot.mu.Lock()
+			if spot.IsOccupied {
+				occupied++
+			}
+			spot.mu.Unlock()
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return float64(occupied) / float64(total) * 100
+}
+
+// RecordRevenue adds amount to the lot's running revenue total.
+func (pl *ParkingLot) RecordRevenue(amount float64) {
+	pl.revMu.Lock()
+	defer pl.revMu.Unlock()
+	pl.revenue += amount
+}
+
+// Revenue reports the lot's total billed revenue so far.
+func (pl *ParkingLot) Revenue() float64 {
+	pl.revMu.Lock()
+	defer pl.revMu.Unlock()
+	return pl.revenue
+}
+
+// ReservationGrace reports the configured no-show grace period, defaulting
+// to zero (no grace) until SetReservationGrace is called.
+func (pl *ParkingLot) ReservationGrace() time.Duration {
+	return pl.reservationGrace
+}
+
+// SetReservationGrace overrides the default no-show grace period.
+func (pl *ParkingLot) SetReservationGrace(d time.Duration) {
+	pl.reservationGrace = d
+}
+
+func (pl *ParkingLot) store() ReservationStore {
+	if pl.reservationStore == nil {
+		pl.reservationStore = NewInMemoryReservationStore()
+	}
+	return pl.reservationStore
+}
+
+// SetReservationStore swaps the persistence backend, e.g. for a DB-backed
+// store in production.
+func (pl *ParkingLot) SetReservationStore(store ReservationStore) {
+	pl.reservationStore = store
+}
+
+func (pl *ParkingLot) nextReservationID() ReservationID {
+	pl.resMu.Lock()
+	defer pl.resMu.Unlock()
+	pl.reservationSeq++
+	return ReservationID(fmt.Sprintf("RES-%d", pl.reservationSeq))
+}
+
+// ReserveSpot holds a spot of the vehicle's type for the given arrival
+// window so FindAndParkVehicle skips it until CheckIn, CancelReservation,
+// or the sweeper releases it as a no-show.
+func (pl *ParkingLot) ReserveSpot(vehicle Vehicle, arrival time.Time, duration time.Duration) (ReservationID, *ParkingSpot, error) {
+	for _, level := range pl.Levels {
+		spot := level.claimFreeSpot(vehicle)
+		if spot == nil {
+			continue
+		}
+		id := pl.nextReservationID()
+		until := arrival.Add(duration).Add(pl.ReservationGrace())
+		spot.hold(id, until)
+		res := &Reservation{
+			ID:       id,
+			Vehicle:  vehicle,
+			Spot:     spot,
+			Level:    level,
+			Arrival:  arrival,
+			Duration: duration,
+			Status:   ReservationHeld,
+		}
+		if err := pl.store().Save(res); err != nil {
+			spot.release(id)
+			level.releaseToFree(spot)
+			return "", nil, err
+		}
+		return id, spot, nil
+	}
+	return "", nil, fmt.Errorf("no spot available for vehicle type %v", vehicle.GetType())
+}
+
+// CancelReservation releases a held spot without parking anyone in it. It
+// takes the reservation out of the store atomically, so a cancel racing the
+// sweeper (or a second concurrent cancel) for the same id can't both push
+// the spot back to the free index.
+func (pl *ParkingLot) CancelReservation(id ReservationID) error {
+	res, ok := pl.store().Take(id)
+	if !ok {
+		return fmt.Errorf("reservation %s not found", id)
+	}
+	if res.Spot.release(id) {
+		res.Level.releaseToFree(res.Spot)
+	}
+	res.Status = ReservationCancelled
+	return nil
+}
+
+// CheckIn parks the reserved vehicle into its held spot and hands back the
+// vehicle along with its spot and level, so a caller (e.g. EntryExitGate) can
+// issue a real Ticket through the Coordinator. Like CancelReservation, it
+// takes the reservation out of the store atomically so it can't race a
+// concurrent cancel or sweeper expiry for the same id.
+func (pl *ParkingLot) CheckIn(id ReservationID) (Vehicle, *ParkingSpot, *ParkingLevel, error) {
+	res, ok := pl.store().Take(id)
+	if !ok {
+		return nil, nil, nil, fmt.Errorf("reservation %s not found", id)
+	}
+	res.Spot.release(id)
+	res.Level.ParkVehicle(res.Spot, &res.Vehicle)
+	res.Status = ReservationCheckedIn
+	pl.NotifyObservers(res.Spot)
+	return res.Vehicle, res.Spot, res.Level, nil
+}
+
+// AvailableSpots counts spots of the given type that are free at the given
+// time, taking reservations that would be held at that time into account.
+func (pl *ParkingLot) AvailableSpots(vt VehicleType, at time.Time) int {
+	count := 0
+	for _, level := range pl.Levels {
+		for _, spot := range level.Spots {
+			if spot.SpotType != vt {
+				continue
+			}
+			spot.mu.Lock()
+			free := !spot.IsOccupied && (spot.ReservationID == "" || at.After(spot.ReservedUntil))
+			spot.mu.Unlock()
+			if free {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// StartReservationSweeper launches a background goroutine that periodically
+// releases no-show reservations, i.e. holds whose arrival window plus grace
+// period has elapsed without a CheckIn. It returns a stop function.
+func (pl *ParkingLot) StartReservationSweeper(interval time.Duration) (stop func()) {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case now := <-ticker.C:
+				for _, res := range pl.store().All() {
+					if res.Status != ReservationHeld || !now.After(res.Spot.ReservedUntil) {
+						continue
+					}
+					taken, ok := pl.store().Take(res.ID)
+					if !ok {
+						continue
+					}
+					if taken.Spot.release(taken.ID) {
+						taken.Level.releaseToFree(taken.Spot)
+					}
+					taken.Status = ReservationExpired
+				}
+			}
+		}
+	}()
+	return func() { close(done) }
+}
+
+func (pl *ParkingLot) RegisterObserver(observer Observer) {
+
+	pl.observers = append(pl.observers, observer)
+}
+
+func (pl *ParkingLot) RemoveObserver(observer Observer) {
+
+	for i, obs := range pl.observers {
+		if obs == observer {
+			pl.observers = append(pl.observers[:i], pl.observers[i+1:]...)
+			break
+		}
+	}
+}
+
+func (pl *ParkingLot) NotifyObservers(spot *ParkingSpot) {
+
+	for _, observer := range pl.observers {
+		observer.Update(spot)
+	}
+}
+
+func (pl *ParkingLot) FindAndParkVehicle(vehicle Vehicle) (*ParkingSpot, *ParkingLevel) {
+	for _, level := range pl.Levels {
+		spot := level.claimFreeSpot(vehicle)
+		if spot != nil {
+			level.ParkVehicle(spot, &vehicle)
+			pl.NotifyObservers(spot)
+			return spot, level
+		}
+
+	}
+	pl.NotifyEvent(Event{Type: SpotDenied, VehicleType: vehicle.GetType(), At: time.Now()})
+	return nil, nil
+}
@@ -0,0 +1,71 @@
+package core
+
+import (
+	"fmt"
+	"time"
+)
+
+// LineItem is one charge making up a Receipt's Amount, e.g. the base hourly
+// rate or a surge surcharge.
+type LineItem struct {
+	Description string
+	Amount      float64
+}
+
+// Pricing computes the amount owed for a completed parking session, given
+// the vehicle type, how long it was parked, and how full the lot was at
+// checkout time.
+type Pricing interface {
+	Price(vt VehicleType, duration time.Duration, occupancyPercent float64) (amount float64, lineItems []LineItem)
+}
+
+// FlatRatePricing charges the same hourly rate regardless of vehicle type.
+type FlatRatePricing struct {
+	RatePerHour float64
+}
+
+func (p FlatRatePricing) Price(vt VehicleType, duration time.Duration, occupancyPercent float64) (float64, []LineItem) {
+	hours := duration.Hours()
+	amount := hours * p.RatePerHour
+	return amount, []LineItem{{
+		Description: fmt.Sprintf("%.2fh @ $%.2f/h flat rate", hours, p.RatePerHour),
+		Amount:      amount,
+	}}
+}
+
+// TieredPricing charges a different hourly rate per vehicle type.
+type TieredPricing struct {
+	RatesPerHour map[VehicleType]float64
+}
+
+func (p TieredPricing) Price(vt VehicleType, duration time.Duration, occupancyPercent float64) (float64, []LineItem) {
+	hours := duration.Hours()
+	rate := p.RatesPerHour[vt]
+	amount := hours * rate
+	return amount, []LineItem{{
+		Description: fmt.Sprintf("%s x %.2fh @ $%.2f/h", vt, hours, rate),
+		Amount:      amount,
+	}}
+}
+
+// DynamicPricing wraps a base Pricing and adds a surge surcharge once the
+// lot's occupancy crosses SurgeThreshold (a percentage), mirroring how
+// transit/parking systems raise rates as capacity fills up.
+type DynamicPricing struct {
+	Base            Pricing
+	SurgeThreshold  float64 // occupancy percent, e.g. 80
+	SurgeMultiplier float64 // e.g. 1.5 for a 50% surcharge
+}
+
+func (p DynamicPricing) Price(vt VehicleType, duration time.Duration, occupancyPercent float64) (float64, []LineItem) {
+	amount, lineItems := p.Base.Price(vt, duration, occupancyPercent)
+	if occupancyPercent <= p.SurgeThreshold {
+		return amount, lineItems
+	}
+	surcharge := amount*p.SurgeMultiplier - amount
+	lineItems = append(lineItems, LineItem{
+		Description: fmt.Sprintf("surge surcharge (%.0f%% full)", occupancyPercent),
+		Amount:      surcharge,
+	})
+	return amount + surcharge, lineItems
+}
@@ -0,0 +1,72 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFlatRatePricing_Price(t *testing.T) {
+	p := FlatRatePricing{RatePerHour: 2.0}
+	amount, lineItems := p.Price(Car, 90*time.Minute, 0)
+	if amount != 3.0 {
+		t.Fatalf("expected 1.5h @ $2/h = $3.00, got $%.2f", amount)
+	}
+	if len(lineItems) != 1 {
+		t.Fatalf("expected 1 line item, got %d", len(lineItems))
+	}
+}
+
+func TestTieredPricing_Price(t *testing.T) {
+	p := TieredPricing{RatesPerHour: map[VehicleType]float64{
+		Car:        2.0,
+		Motorcycle: 1.0,
+		Truck:      4.0,
+	}}
+
+	cases := []struct {
+		name string
+		vt   VehicleType
+		want float64
+	}{
+		{"car", Car, 2.0},
+		{"motorcycle", Motorcycle, 1.0},
+		{"truck", Truck, 4.0},
+		{"unmapped vehicle type", VehicleType(99), 0},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			amount, _ := p.Price(c.vt, time.Hour, 0)
+			if amount != c.want {
+				t.Fatalf("expected $%.2f for 1h, got $%.2f", c.want, amount)
+			}
+		})
+	}
+}
+
+func TestDynamicPricing_Price(t *testing.T) {
+	base := FlatRatePricing{RatePerHour: 10.0}
+	p := DynamicPricing{Base: base, SurgeThreshold: 80, SurgeMultiplier: 1.5}
+
+	cases := []struct {
+		name             string
+		occupancyPercent float64
+		wantAmount       float64
+		wantLineItems    int
+	}{
+		{"below threshold", 50, 10.0, 1},
+		{"at threshold boundary is not surged", 80, 10.0, 1},
+		{"just above threshold surges", 80.01, 15.0, 2},
+		{"well above threshold surges", 100, 15.0, 2},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			amount, lineItems := p.Price(Car, time.Hour, c.occupancyPercent)
+			if amount != c.wantAmount {
+				t.Fatalf("expected $%.2f at %.2f%% occupancy, got $%.2f", c.wantAmount, c.occupancyPercent, amount)
+			}
+			if len(lineItems) != c.wantLineItems {
+				t.Fatalf("expected %d line items, got %d: %+v", c.wantLineItems, len(lineItems), lineItems)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package core
+
+import (
+	"sync"
+	"time"
+)
+
+// ReservationID identifies a pre-booking of a parking spot.
+type ReservationID string
+
+// ReservationStatus tracks a reservation through its lifecycle.
+type ReservationStatus int
+
+const (
+	ReservationHeld ReservationStatus = iota
+	ReservationCheckedIn
+	ReservationCancelled
+	ReservationExpired
+)
+
+// Reservation is a pre-booking of a specific spot for a vehicle's arrival
+// window. ParkingLot holds the spot from the moment the reservation is made
+// until CheckIn, CancelReservation, or the sweeper expires it as a no-show.
+type Reservation struct {
+	ID       ReservationID
+	Vehicle  Vehicle
+	Spot     *ParkingSpot
+	Level    *ParkingLevel
+	Arrival  time.Time
+	Duration time.Duration
+	Status   ReservationStatus
+}
+
+// ReservationStore persists reservations. It is the extension point that
+// lets an in-memory store be swapped for a DB-backed one without touching
+// ParkingLot.
+type ReservationStore interface {
+	Save(res *Reservation) error
+	Get(id ReservationID) (*Reservation, bool)
+	Delete(id ReservationID)
+	Take(id ReservationID) (*Reservation, bool)
+	All() []*Reservation
+}
+
+// InMemoryReservationStore is the default ReservationStore, backed by a map.
+type InMemoryReservationStore struct {
+	mu           sync.Mutex
+	reservations map[ReservationID]*Reservation
+}
+
+func NewInMemoryReservationStore() *InMemoryReservationStore {
+	return &InMemoryReservationStore{
+		reservations: make(map[ReservationID]*Reservation),
+	}
+}
+
+func (s *InMemoryReservationStore) Save(res *Reservation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reservations[res.ID] = res
+	return nil
+}
+
+func (s *InMemoryReservationStore) Get(id ReservationID) (*Reservation, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res, ok := s.reservations[id]
+	return res, ok
+}
+
+func (s *InMemoryReservationStore) Delete(id ReservationID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.reservations, id)
+}
+
+// Take atomically looks up and removes a reservation in a single locked step,
+// so at most one of a concurrent CancelReservation/CheckIn/sweeper-expiry for
+// the same id ever observes ok == true.
+func (s *InMemoryReservationStore) Take(id ReservationID) (*Reservation, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res, ok := s.reservations[id]
+	if ok {
+		delete(s.reservations, id)
+	}
+	return res, ok
+}
+
+func (s *InMemoryReservationStore) All() []*Reservation {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	all := make([]*Reservation, 0, len(s.reservations))
+	for _, res := range s.reservations {
+		all = append(all, res)
+	}
+	return all
+}
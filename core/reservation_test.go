@@ -0,0 +1,158 @@
+package core
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestParkingLot_ConcurrentCancelReservation_NoDoubleRelease is a regression
+// test for a race where two concurrent CancelReservation calls for the same
+// reservation could both see the spot as held and both push it back onto
+// the level's free-spot channel, handing the same spot out to two vehicles.
+// CancelReservation now takes the reservation out of the store atomically,
+// so only one of the racing callers ever releases it back to free.
+func TestParkingLot_ConcurrentCancelReservation_NoDoubleRelease(t *testing.T) {
+	lot := &ParkingLot{Levels: []*ParkingLevel{
+		NewParkingLevel(1, []*ParkingSpot{{SpotID: 1, SpotType: Car}}),
+	}}
+
+	vehicle := CarVehicle{Plate: "CAR-1"}
+	id, spot, err := lot.ReserveSpot(vehicle, time.Now(), time.Hour)
+	if err != nil {
+		t.Fatalf("ReserveSpot: %v", err)
+	}
+
+	const racers = 20
+	var wg sync.WaitGroup
+	var succeeded int
+	var mu sync.Mutex
+	wg.Add(racers)
+	for i := 0; i < racers; i++ {
+		go func() {
+			defer wg.Done()
+			if err := lot.CancelReservation(id); err == nil {
+				mu.Lock()
+				succeeded++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if succeeded != 1 {
+		t.Fatalf("expected exactly 1 CancelReservation to succeed, got %d", succeeded)
+	}
+
+	level := lot.Levels[0]
+	first := level.claimFreeSpot(vehicle)
+	if first != spot {
+		t.Fatalf("expected to reclaim the released spot, got %v", first)
+	}
+	second := level.claimFreeSpot(vehicle)
+	if second != nil {
+		t.Fatalf("spot was pushed to the free index more than once: got extra claim %v", second)
+	}
+}
+
+func TestParkingLot_CheckIn_ParksVehicleAndMarksSpotOccupied(t *testing.T) {
+	lot := &ParkingLot{Levels: []*ParkingLevel{
+		NewParkingLevel(1, []*ParkingSpot{{SpotID: 1, SpotType: Car}}),
+	}}
+
+	vehicle := CarVehicle{Plate: "CAR-1"}
+	id, reservedSpot, err := lot.ReserveSpot(vehicle, time.Now(), time.Hour)
+	if err != nil {
+		t.Fatalf("ReserveSpot: %v", err)
+	}
+
+	checkedInVehicle, spot, level, err := lot.CheckIn(id)
+	if err != nil {
+		t.Fatalf("CheckIn: %v", err)
+	}
+	if spot != reservedSpot {
+		t.Fatalf("expected CheckIn to return the reserved spot, got %v", spot)
+	}
+	if checkedInVehicle.ID() != vehicle.ID() {
+		t.Fatalf("expected CheckIn to return the reserved vehicle, got %v", checkedInVehicle)
+	}
+	if level.LevelID != 1 {
+		t.Fatalf("expected level 1, got %d", level.LevelID)
+	}
+	if !spot.Occupied() {
+		t.Fatal("expected CheckIn to park the vehicle into the spot")
+	}
+
+	if _, _, _, err := lot.CheckIn(id); err == nil {
+		t.Fatal("expected a second CheckIn of the same reservation to fail")
+	}
+}
+
+func TestParkingLot_AvailableSpots_ExcludesHeldReservation(t *testing.T) {
+	lot := &ParkingLot{Levels: []*ParkingLevel{
+		NewParkingLevel(1, []*ParkingSpot{
+			{SpotID: 1, SpotType: Car},
+			{SpotID: 2, SpotType: Car},
+		}),
+	}}
+
+	now := time.Now()
+	if got := lot.AvailableSpots(Car, now); got != 2 {
+		t.Fatalf("expected 2 available car spots before any reservation, got %d", got)
+	}
+
+	vehicle := CarVehicle{Plate: "CAR-1"}
+	arrival := now.Add(time.Hour)
+	if _, _, err := lot.ReserveSpot(vehicle, arrival, time.Hour); err != nil {
+		t.Fatalf("ReserveSpot: %v", err)
+	}
+
+	if got := lot.AvailableSpots(Car, now); got != 1 {
+		t.Fatalf("expected 1 available car spot while the other is held, got %d", got)
+	}
+
+	// Once the reservation's window (arrival+duration+grace) has passed, the
+	// held spot counts as available again even without an explicit release.
+	afterWindow := arrival.Add(2 * time.Hour)
+	if got := lot.AvailableSpots(Car, afterWindow); got != 2 {
+		t.Fatalf("expected both spots available once the reservation window has passed, got %d", got)
+	}
+}
+
+// TestParkingLot_StartReservationSweeper_ExpiresNoShow is a regression test
+// for the no-show path: a reservation whose arrival+duration+grace has
+// already elapsed must be swept back to the free index without ever being
+// checked in.
+func TestParkingLot_StartReservationSweeper_ExpiresNoShow(t *testing.T) {
+	lot := &ParkingLot{Levels: []*ParkingLevel{
+		NewParkingLevel(1, []*ParkingSpot{{SpotID: 1, SpotType: Car}}),
+	}}
+
+	vehicle := CarVehicle{Plate: "NO-SHOW"}
+	// Arrival window is already in the past, so the very first sweep tick
+	// should treat this as an expired no-show.
+	id, spot, err := lot.ReserveSpot(vehicle, time.Now().Add(-time.Hour), time.Minute)
+	if err != nil {
+		t.Fatalf("ReserveSpot: %v", err)
+	}
+
+	stop := lot.StartReservationSweeper(10 * time.Millisecond)
+	defer stop()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		if res, ok := lot.store().Get(id); !ok || res == nil {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if _, ok := lot.store().Get(id); ok {
+		t.Fatal("expected the sweeper to have removed the expired reservation")
+	}
+
+	reclaimed := lot.Levels[0].claimFreeSpot(vehicle)
+	if reclaimed != spot {
+		t.Fatalf("expected the sweeper to release the spot back to free, got %v", reclaimed)
+	}
+}
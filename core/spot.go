@@ -0,0 +1,68 @@
+package core
+
+import (
+	"sync"
+	"time"
+)
+
+type ParkingSpot struct {
+	SpotID        int
+	SpotType      VehicleType
+	IsOccupied    bool
+	LevelID       int           // level this spot belongs to, stamped by NewParkingLevel
+	ReservationID ReservationID // non-empty while the spot is held for a future arrival
+	ReservedUntil time.Time     // reservation + grace period; spot is free again after this
+	mu            sync.Mutex    // Lock for spot-level concurrency
+}
+
+func (ps *ParkingSpot) Park() {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ps.IsOccupied = true
+}
+
+func (ps *ParkingSpot) Leave() {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ps.IsOccupied = false
+}
+
+// Occupied reports whether the spot is currently occupied, synchronized
+// against concurrent Park/Leave calls - callers outside this package can't
+// take ps.mu directly, so this is the safe way for them to read IsOccupied
+// while another goroutine may be parking or releasing the spot.
+func (ps *ParkingSpot) Occupied() bool {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	return ps.IsOccupied
+}
+
+// hold marks the spot as reserved so the free-spot index won't hand it to a
+// walk-in until release or checkIn is called. until is the arrival window
+// plus the grace period.
+func (ps *ParkingSpot) hold(id ReservationID, until time.Time) bool {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	if ps.IsOccupied || ps.ReservationID != "" {
+		return false
+	}
+	ps.ReservationID = id
+	ps.ReservedUntil = until
+	return true
+}
+
+// release clears a hold placed by hold, regardless of whether the vehicle
+// ever checked in. It reports whether it actually cleared the hold, so a
+// caller racing another release of the same reservation (e.g. CancelReservation
+// racing the sweeper) can tell whether it won and must push the spot back to
+// the free index, or lost and must not.
+func (ps *ParkingSpot) release(id ReservationID) bool {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	if ps.ReservationID != id {
+		return false
+	}
+	ps.ReservationID = ""
+	ps.ReservedUntil = time.Time{}
+	return true
+}
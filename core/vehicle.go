@@ -0,0 +1,72 @@
+// Package core holds the parking lot's domain model: vehicles, spots,
+// levels, reservations, pricing, and the ParkingLot that ties them
+// together. It has no dependency on the coordinator, analytics, or feed
+// packages, which all depend on it instead.
+package core
+
+type VehicleType int
+
+const (
+	Car VehicleType = iota
+	Motorcycle
+	Truck
+)
+
+func (vt VehicleType) String() string {
+	switch vt {
+	case Car:
+		return "Car"
+	case Motorcycle:
+		return "Motorcycle"
+	case Truck:
+		return "Truck"
+	default:
+		return "Unknown"
+	}
+}
+
+// VehicleID identifies a specific vehicle, e.g. a license plate or RFID
+// token, so the Coordinator can track it rather than treating every car as
+// an interchangeable value.
+type VehicleID string
+
+type Vehicle interface {
+	GetType() VehicleType
+	ID() VehicleID
+}
+
+type CarVehicle struct {
+	Plate string
+}
+
+func (c CarVehicle) GetType() VehicleType {
+	return Car
+}
+
+func (c CarVehicle) ID() VehicleID {
+	return VehicleID(c.Plate)
+}
+
+type TruckVehicle struct {
+	Plate string
+}
+
+func (tv TruckVehicle) GetType() VehicleType {
+	return Truck
+}
+
+func (tv TruckVehicle) ID() VehicleID {
+	return VehicleID(tv.Plate)
+}
+
+type MotorcycleVehicle struct {
+	Plate string
+}
+
+func (m MotorcycleVehicle) GetType() VehicleType {
+	return Motorcycle
+}
+
+func (m MotorcycleVehicle) ID() VehicleID {
+	return VehicleID(m.Plate)
+}
@@ -0,0 +1,130 @@
+package feed
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tronglv92/low_level_design_system/core"
+)
+
+func newTestLot() *core.ParkingLot {
+	level := core.NewParkingLevel(1, []*core.ParkingSpot{
+		{SpotID: 1, SpotType: core.Car},
+		{SpotID: 2, SpotType: core.Car},
+		{SpotID: 3, SpotType: core.Motorcycle},
+	})
+	return &core.ParkingLot{Levels: []*core.ParkingLevel{level}}
+}
+
+func TestFeedPublisher_Subscribe_ReceivesBatchedUpdates(t *testing.T) {
+	lot := newTestLot()
+	pub := NewFeedPublisher(lot, time.Hour) // Start() not used; flush is driven manually
+
+	sub, cancel := pub.Subscribe()
+	defer cancel()
+
+	pub.Update(lot.Levels[0].Spots[0])
+	pub.Update(lot.Levels[0].Spots[1])
+	pub.flush()
+
+	select {
+	case batch := <-sub:
+		if len(batch) != 2 {
+			t.Fatalf("expected a batch of 2 updates, got %d", len(batch))
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for batched updates")
+	}
+}
+
+func TestFeedPublisher_Flush_NoOpWhenNothingPending(t *testing.T) {
+	lot := newTestLot()
+	pub := NewFeedPublisher(lot, time.Hour)
+	sub, cancel := pub.Subscribe()
+	defer cancel()
+
+	pub.flush() // nothing queued
+
+	select {
+	case batch := <-sub:
+		t.Fatalf("expected no batch when nothing was pending, got %v", batch)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestFeedPublisher_Subscribe_CancelStopsDelivery(t *testing.T) {
+	lot := newTestLot()
+	pub := NewFeedPublisher(lot, time.Hour)
+	sub, cancel := pub.Subscribe()
+	cancel()
+
+	pub.Update(lot.Levels[0].Spots[0])
+	pub.flush()
+
+	select {
+	case batch, ok := <-sub:
+		if ok {
+			t.Fatalf("expected no delivery after cancel, got %v", batch)
+		}
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+// TestFeedPublisher_Flush_DropsBatchForSlowConsumer exercises flush's
+// slow-consumer path: a subscriber whose buffered channel is already full
+// must have the new batch dropped rather than block the publisher.
+func TestFeedPublisher_Flush_DropsBatchForSlowConsumer(t *testing.T) {
+	lot := newTestLot()
+	pub := NewFeedPublisher(lot, time.Hour)
+	sub, cancel := pub.Subscribe()
+	defer cancel()
+
+	// Fill the subscriber's buffered channel (capacity 16) without draining it.
+	for i := 0; i < 16; i++ {
+		pub.Update(lot.Levels[0].Spots[0])
+		pub.flush()
+	}
+
+	// One more update/flush should be dropped silently instead of blocking.
+	done := make(chan struct{})
+	go func() {
+		pub.Update(lot.Levels[0].Spots[0])
+		pub.flush()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("flush blocked on a full subscriber channel instead of dropping the batch")
+	}
+
+	if got := len(sub); got != 16 {
+		t.Fatalf("expected the subscriber channel to stay full at 16, got %d", got)
+	}
+}
+
+func TestFeedPublisher_Snapshot_AggregatesCapacityAndOccupancy(t *testing.T) {
+	lot := newTestLot()
+	lot.Levels[0].Spots[0].Park() // occupy one of the two Car spots
+
+	pub := NewFeedPublisher(lot, time.Hour)
+	statuses := pub.Snapshot()
+
+	if len(statuses) != 1 {
+		t.Fatalf("expected 1 level status, got %d", len(statuses))
+	}
+	status := statuses[0]
+	if status.CapacityByType[core.Car] != 2 {
+		t.Fatalf("expected 2 car spots of capacity, got %d", status.CapacityByType[core.Car])
+	}
+	if status.OccupiedByType[core.Car] != 1 {
+		t.Fatalf("expected 1 occupied car spot, got %d", status.OccupiedByType[core.Car])
+	}
+	if status.CapacityByType[core.Motorcycle] != 1 {
+		t.Fatalf("expected 1 motorcycle spot of capacity, got %d", status.CapacityByType[core.Motorcycle])
+	}
+	if status.OccupiedByType[core.Motorcycle] != 0 {
+		t.Fatalf("expected 0 occupied motorcycle spots, got %d", status.OccupiedByType[core.Motorcycle])
+	}
+}
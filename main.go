@@ -0,0 +1,344 @@
+// Designing a Parking Lot System
+
+// The parking lot should have multiple levels, each level with a certain number of parking spots.
+// The parking lot should support different types of vehicles, such as cars, motorcycles, and trucks.
+// Each parking spot should be able to accommodate a specific type of vehicle.
+// The system should assign a parking spot to a vehicle upon entry and release it when the vehicle exits.
+// The system should track the availability of parking spots and provide real-time information to customers.
+// The system should handle multiple entry and exit points and support concurrent access.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/tronglv92/low_level_design_system/analytics"
+	"github.com/tronglv92/low_level_design_system/core"
+	"github.com/tronglv92/low_level_design_system/core/coordinator"
+	"github.com/tronglv92/low_level_design_system/feed"
+)
+
+// EntryExitGate is the user-facing front door to the lot: it talks to the
+// Coordinator for vehicle entry/exit and to the ParkingLot directly for the
+// reservation booking API.
+type EntryExitGate struct {
+	ParkingLot  *core.ParkingLot
+	Coordinator *coordinator.Coordinator
+	Pricing     core.Pricing
+}
+
+func (gate *EntryExitGate) Enter(vehicle core.Vehicle) (coordinator.Ticket, error) {
+	ticket, err := gate.Coordinator.Assign(vehicle)
+	if err != nil {
+		fmt.Printf("No available spot for this vehicle type: %v\n", err)
+		return coordinator.Ticket{}, err
+	}
+	fmt.Printf("Vehicle %v parked at Level %d, Spot %d \n", vehicle.GetType(), ticket.Level.LevelID, ticket.Spot.SpotID)
+	gate.ParkingLot.NotifyEvent(core.Event{Type: core.VehicleEntered, LevelID: ticket.Level.LevelID, VehicleType: ticket.VehicleType, At: time.Now()})
+	return ticket, nil
+}
+
+// ReserveSpot holds a spot of vehicle's type for its arrival window; see
+// core.ParkingLot.ReserveSpot.
+func (gate *EntryExitGate) ReserveSpot(vehicle core.Vehicle, arrival time.Time, duration time.Duration) (core.ReservationID, *core.ParkingSpot, error) {
+	return gate.ParkingLot.ReserveSpot(vehicle, arrival, duration)
+}
+
+// CancelReservation releases a held spot without parking anyone in it; see
+// core.ParkingLot.CancelReservation.
+func (gate *EntryExitGate) CancelReservation(id core.ReservationID) error {
+	return gate.ParkingLot.CancelReservation(id)
+}
+
+// CheckIn parks the reserved vehicle into its held spot and issues it a real
+// Ticket through the Coordinator, so the resulting parking session is
+// billed, tracked, and exitable through Exit exactly like a walk-in's.
+func (gate *EntryExitGate) CheckIn(id core.ReservationID) (coordinator.Ticket, error) {
+	vehicle, spot, level, err := gate.ParkingLot.CheckIn(id)
+	if err != nil {
+		fmt.Printf("Check-in failed: %v\n", err)
+		return coordinator.Ticket{}, err
+	}
+	ticket, err := gate.Coordinator.AssignReserved(vehicle, spot, level)
+	if err != nil {
+		return coordinator.Ticket{}, err
+	}
+	fmt.Printf("Reserved vehicle %v checked in at Level %d, Spot %d \n", vehicle.GetType(), level.LevelID, spot.SpotID)
+	gate.ParkingLot.NotifyEvent(core.Event{Type: core.VehicleEntered, LevelID: level.LevelID, VehicleType: vehicle.GetType(), At: time.Now()})
+	return ticket, nil
+}
+
+func (gate *EntryExitGate) Exit(ticket coordinator.Ticket) (coordinator.Receipt, error) {
+	receipt, err := gate.Coordinator.Release(ticket)
+	if err != nil {
+		fmt.Printf("Exit failed: %v\n", err)
+		return coordinator.Receipt{}, err
+	}
+	if gate.Pricing != nil {
+		amount, lineItems := gate.Pricing.Price(ticket.VehicleType, receipt.Duration, gate.ParkingLot.OccupancyPercent())
+		receipt.Amount = amount
+		receipt.LineItems = lineItems
+		gate.ParkingLot.RecordRevenue(amount)
+	}
+	fmt.Printf("Vehicle exited from Level %d, Spot %d - charged $%.2f\n", ticket.Level.LevelID, ticket.Spot.SpotID, receipt.Amount)
+	gate.ParkingLot.NotifyEvent(core.Event{Type: core.VehicleExited, LevelID: ticket.Level.LevelID, VehicleType: ticket.VehicleType, At: time.Now()})
+	gate.ParkingLot.NotifyEvent(core.Event{Type: core.WaitTime, LevelID: ticket.Level.LevelID, VehicleType: ticket.VehicleType, Duration: receipt.Duration, At: time.Now()})
+	return receipt, nil
+}
+
+// streamFeedConsumer is a small example of a feed consumer: it subscribes
+// to the differential stream and logs each batch as it arrives.
+func streamFeedConsumer(publisher *feed.FeedPublisher, done <-chan struct{}) {
+	updates, cancel := publisher.Subscribe()
+	defer cancel()
+	for {
+		select {
+		case batch := <-updates:
+			for _, u := range batch {
+				fmt.Printf("feed consumer: level %d spot %d now occupied=%v\n", u.LevelID, u.SpotID, u.Occupied)
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
+// Concrete Observer
+type ParkingStatusObserver struct {
+	name string
+}
+
+func (observer *ParkingStatusObserver) Update(spot *core.ParkingSpot) {
+	status := "Occupied"
+	if !spot.Occupied() {
+		status = "Available"
+	}
+	fmt.Printf("%s - Spot %d:%s\n", observer.name, spot.SpotID, status)
+}
+
+// printParkingStatus prints every level's current spot occupancy.
+func printParkingStatus(lot *core.ParkingLot) {
+	for _, level := range lot.Levels {
+		fmt.Printf("Level %d:\n", level.LevelID)
+		for _, spot := range level.Spots {
+			spotStatus := "Available"
+			if spot.Occupied() {
+				spotStatus = "Occupied"
+			}
+			fmt.Printf("Spot %d: %s\n", spot.SpotID, spotStatus)
+		}
+	}
+}
+
+func main() {
+	level1 := core.NewParkingLevel(1, []*core.ParkingSpot{
+		{SpotID: 1, SpotType: core.Car, IsOccupied: false},
+		{SpotID: 2, SpotType: core.Motorcycle, IsOccupied: false},
+		{SpotID: 3, SpotType: core.Car, IsOccupied: false},
+		{SpotID: 4, SpotType: core.Truck, IsOccupied: false},
+	})
+
+	parkingLot := &core.ParkingLot{
+		Levels: []*core.ParkingLevel{
+			level1,
+		},
+	}
+	// Create a coordinator to track vehicle identity -> spot assignments,
+	// then wire the gate through it instead of talking to the lot directly.
+	coord := coordinator.NewCoordinator(parkingLot)
+	pricing := core.DynamicPricing{
+		Base: core.TieredPricing{RatesPerHour: map[core.VehicleType]float64{
+			core.Car:        2.0,
+			core.Motorcycle: 1.0,
+			core.Truck:      4.0,
+		}},
+		SurgeThreshold:  80,
+		SurgeMultiplier: 1.5,
+	}
+	gate := &EntryExitGate{ParkingLot: parkingLot, Coordinator: coord, Pricing: pricing}
+
+	// Create observers for parking status
+	observer1 := &ParkingStatusObserver{name: "Observer 1"}
+
+	// Register observers to the parking lot
+	parkingLot.RegisterObserver(observer1)
+
+	// Wire an analytics observer for event counts and rolling occupancy.
+	analyticsSink := analytics.NewAnalytics()
+	analyticsObserver := analytics.NewAnalyticsObserver(parkingLot, analyticsSink)
+	parkingLot.RegisterObserver(analyticsObserver)
+	parkingLot.RegisterEventObserver(analyticsObserver)
+
+	// Wire a real-time availability feed publisher and a sample consumer.
+	feedPublisher := feed.NewFeedPublisher(parkingLot, 200*time.Millisecond)
+	parkingLot.RegisterObserver(feedPublisher)
+	stopFeed := feedPublisher.Start()
+	defer stopFeed()
+	consumerDone := make(chan struct{})
+	go streamFeedConsumer(feedPublisher, consumerDone)
+	defer close(consumerDone)
+
+	// Show parking status before vehicles enter
+	fmt.Println("Parking Lot Status Before Vehicles Enter:")
+	printParkingStatus(parkingLot)
+
+	// Create a WaitGroup to handle concurrent operations
+	var wg sync.WaitGroup
+
+	// Test Case 1: Multiple Vehicles Entering Simultaneously
+	fmt.Println("Test Case 1: Multiple Vehicles Entering Simultaneously")
+	var wg1 sync.WaitGroup
+	wg1.Add(3)
+
+	var carTicket1, motoTicket1, truckTicket1 coordinator.Ticket
+
+	go func() {
+		defer wg1.Done()
+		car := core.CarVehicle{Plate: "CAR-1"}
+		carTicket1, _ = gate.Enter(car)
+	}()
+
+	go func() {
+		defer wg1.Done()
+		motorcycle := core.MotorcycleVehicle{Plate: "MOTO-1"}
+		motoTicket1, _ = gate.Enter(motorcycle)
+	}()
+
+	go func() {
+		defer wg1.Done()
+		truck := core.TruckVehicle{Plate: "TRUCK-1"}
+		truckTicket1, _ = gate.Enter(truck)
+	}()
+	wg1.Wait()
+	printParkingStatus(parkingLot)
+
+	// Test Case 2: Multiple Vehicles Exiting Simultaneously
+	fmt.Println("\nTest Case 2: Multiple Vehicles Exiting Simultaneously")
+	var wg2 sync.WaitGroup
+	wg2.Add(2)
+
+	go func() {
+		defer wg2.Done()
+		gate.Exit(carTicket1) // the ticket the car got on entry, not an assumed spot index
+	}()
+
+	go func() {
+		defer wg2.Done()
+		gate.Exit(motoTicket1)
+	}()
+	wg2.Wait()
+	printParkingStatus(parkingLot)
+
+	// Test Case 3: Mixed Entries and Exits
+	fmt.Println("\nTest Case 3: Mixed Entries and Exits")
+	var wg3 sync.WaitGroup
+	wg3.Add(4)
+
+	go func() {
+		defer wg3.Done()
+		car := core.CarVehicle{Plate: "CAR-2"}
+		gate.Enter(car)
+	}()
+
+	go func() {
+		defer wg3.Done()
+		motorcycle := core.MotorcycleVehicle{Plate: "MOTO-2"}
+		gate.Enter(motorcycle)
+	}()
+
+	go func() {
+		defer wg3.Done()
+		gate.Exit(truckTicket1) // the truck from Test Case 1, still parked
+	}()
+
+	go func() {
+		defer wg3.Done()
+		// carTicket1 was already closed out in Test Case 2: this demonstrates
+		// that the gate now rejects a stale ticket instead of silently
+		// releasing whatever vehicle happens to be in a hardcoded spot.
+		if _, err := gate.Exit(carTicket1); err == nil {
+			fmt.Println("unexpected: stale ticket should not exit cleanly")
+		}
+	}()
+	wg3.Wait()
+	printParkingStatus(parkingLot)
+
+	// Test concurrent exits for occupied spots
+	fmt.Println("\nSimulating Concurrent Vehicle Exits:")
+	wg.Add(2)
+
+	// Test Case 4: High Concurrency with More Vehicles than Spots
+	fmt.Println("\nTest Case 4: High Concurrency with More Vehicles than Spots")
+	var wg4 sync.WaitGroup
+	for i := 0; i < 10; i++ { // 10 vehicles trying to enter
+		wg4.Add(1)
+		go func(i int, vehicleType core.VehicleType) {
+			defer wg4.Done()
+			plate := fmt.Sprintf("V%d-%d", i, vehicleType)
+			if vehicleType == core.Car {
+				gate.Enter(core.CarVehicle{Plate: plate})
+			} else if vehicleType == core.Motorcycle {
+				gate.Enter(core.MotorcycleVehicle{Plate: plate})
+			} else {
+				gate.Enter(core.TruckVehicle{Plate: plate})
+			}
+		}(i, core.VehicleType(i%3)) // Cycling through Car, Motorcycle, and Truck
+	}
+	wg4.Wait()
+	printParkingStatus(parkingLot)
+	fmt.Printf("Active vehicles tracked by coordinator: %d\n", len(coord.ActiveVehicles()))
+
+	// Test Case 5: Rapid Entry and Exit on the Same Spot
+	fmt.Println("\nTest Case 5: Rapid Entry and Exit on the Same Spot")
+	var wg5 sync.WaitGroup
+	wg5.Add(2)
+
+	go func() {
+		defer wg5.Done()
+		for i := 0; i < 5; i++ {
+			car := core.CarVehicle{Plate: fmt.Sprintf("CAR-5-%d", i)}
+			ticket, err := gate.Enter(car)
+			time.Sleep(100 * time.Millisecond) // Short delay to simulate real scenario
+			if err == nil {
+				gate.Exit(ticket)
+			}
+		}
+	}()
+
+	go func() {
+		defer wg5.Done()
+		for i := 0; i < 5; i++ {
+			motorcycle := core.MotorcycleVehicle{Plate: fmt.Sprintf("MOTO-5-%d", i)}
+			ticket, err := gate.Enter(motorcycle)
+			time.Sleep(100 * time.Millisecond)
+			if err == nil {
+				gate.Exit(ticket)
+			}
+		}
+	}()
+	wg5.Wait()
+	printParkingStatus(parkingLot)
+	// Show parking status after concurrent entries
+	fmt.Println("\nParking Lot Status After Concurrent Entries:")
+	printParkingStatus(parkingLot)
+
+	fmt.Printf("\nTotal revenue billed: $%.2f\n", parkingLot.Revenue())
+
+	now := time.Now()
+	fmt.Printf("Analytics: %d entries, %d exits, %d denied, avg wait %v\n",
+		analyticsSink.EventCount(core.VehicleEntered, now),
+		analyticsSink.EventCount(core.VehicleExited, now),
+		analyticsSink.EventCount(core.SpotDenied, now),
+		analyticsSink.AverageWaitTime())
+
+	if snapshot, err := json.Marshal(feedPublisher.Snapshot()); err == nil {
+		fmt.Printf("Feed snapshot: %s\n", snapshot)
+	}
+
+	// Remove observers after usage
+	parkingLot.RemoveObserver(observer1)
+
+}